@@ -0,0 +1,412 @@
+package cert
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+
+	"go.etcd.io/bbolt"
+)
+
+// certsBucket holds one entry per certificate, keyed by id, which doubles
+// as the primary store for Save/ByID and as the ascending iteration order
+// used by Paginate.
+var certsBucket = []byte("certs")
+
+// blockIndexBucket is a secondary index mapping blk/<be-uint64 block
+// number>/<id> -> id, so ByBlockRange can seek directly to the start of a
+// range instead of scanning the whole bucket.
+var blockIndexBucket = []byte("certs_by_block")
+
+// epochIndexBucket is a secondary index mapping
+// epoch/<be-uint64 epoch>/<be-uint64 block number>/<id> -> id. The block
+// number is embedded in the key (not just the epoch) so that iterating an
+// epoch's range yields certificates in block-number order, matching the
+// Store.ByEpoch contract.
+var epochIndexBucket = []byte("certs_by_epoch")
+
+// BoltStore is an embedded, single-file Store implementation backed by
+// BoltDB. It lets operators run a node without standing up Postgres.
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path and
+// returns a Store backed by it.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, b := range [][]byte{certsBucket, blockIndexBucket, epochIndexBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying database file.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+var _ Store = (*BoltStore)(nil)
+
+func (s *BoltStore) Save(ctx context.Context, cert *Certificate) error {
+	buf, err := json.Marshal(cert)
+	if err != nil {
+		return err
+	}
+
+	id := []byte(cert.Id)
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		certBucket := tx.Bucket(certsBucket)
+		blockBucket := tx.Bucket(blockIndexBucket)
+		epochBucket := tx.Bucket(epochIndexBucket)
+
+		// If a certificate with this id already exists, its block
+		// number/epoch may have changed, so its old index entries must be
+		// removed before the new ones are written.
+		if old := certBucket.Get(id); old != nil {
+			var prev Certificate
+			if err := json.Unmarshal(old, &prev); err != nil {
+				return err
+			}
+			if err := blockBucket.Delete(blockIndexKey(prev.BlockNumber, prev.Id)); err != nil {
+				return err
+			}
+			if err := epochBucket.Delete(epochIndexKey(prev.Epoch, prev.BlockNumber, prev.Id)); err != nil {
+				return err
+			}
+		}
+
+		if err := certBucket.Put(id, buf); err != nil {
+			return err
+		}
+		if err := blockBucket.Put(blockIndexKey(cert.BlockNumber, cert.Id), id); err != nil {
+			return err
+		}
+		return epochBucket.Put(epochIndexKey(cert.Epoch, cert.BlockNumber, cert.Id), id)
+	})
+}
+
+func (s *BoltStore) Recent(ctx context.Context) ([]Certificate, error) {
+	var certs []Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(certsBucket).Cursor()
+		for k, v := c.Last(); k != nil && len(certs) < 20; k, v = c.Prev() {
+			var cert Certificate
+			if err := json.Unmarshal(v, &cert); err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		return nil
+	})
+
+	return certs, err
+}
+
+// ByBlockRange seeks directly to the start of the range in the block
+// index and walks forward only as far as the range requires, so cost is
+// O(range) rather than O(total certificates).
+func (s *BoltStore) ByBlockRange(ctx context.Context, from, to uint64) ([]Certificate, error) {
+	var certs []Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		certBucket := tx.Bucket(certsBucket)
+		cur := tx.Bucket(blockIndexBucket).Cursor()
+
+		prefix := []byte("blk/")
+
+		// to+1 would overflow when to is math.MaxUint64; treat that as
+		// "no upper bound" instead of wrapping to a stop key of 0, which
+		// would make every key appear past the end of the range.
+		hasUpperBound := to != math.MaxUint64
+		var stop []byte
+		if hasUpperBound {
+			stop = blockIndexPrefix(to + 1)
+		}
+
+		for k, id := cur.Seek(blockIndexPrefix(from)); k != nil && bytes.HasPrefix(k, prefix); k, id = cur.Next() {
+			if hasUpperBound && bytes.Compare(k, stop) >= 0 {
+				break
+			}
+			v := certBucket.Get(id)
+			if v == nil {
+				continue
+			}
+			var cert Certificate
+			if err := json.Unmarshal(v, &cert); err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		return nil
+	})
+
+	return certs, err
+}
+
+// ByEpoch seeks directly to the start of the epoch's key range in the
+// epoch index, which returns certificates in block-number order because
+// the block number is embedded in the index key.
+func (s *BoltStore) ByEpoch(ctx context.Context, epoch uint64) ([]Certificate, error) {
+	var certs []Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		certBucket := tx.Bucket(certsBucket)
+		cur := tx.Bucket(epochIndexBucket).Cursor()
+
+		prefix := epochIndexPrefix(epoch)
+		for k, id := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, id = cur.Next() {
+			v := certBucket.Get(id)
+			if v == nil {
+				continue
+			}
+			var cert Certificate
+			if err := json.Unmarshal(v, &cert); err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		return nil
+	})
+
+	return certs, err
+}
+
+func (s *BoltStore) Paginate(ctx context.Context, afterID string, limit int) ([]Certificate, error) {
+	var certs []Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		cur := tx.Bucket(certsBucket).Cursor()
+
+		var k, v []byte
+		if afterID == "" {
+			k, v = cur.First()
+		} else {
+			k, v = cur.Seek([]byte(afterID))
+			if k != nil && string(k) == afterID {
+				k, v = cur.Next()
+			}
+		}
+
+		for ; k != nil && len(certs) < limit; k, v = cur.Next() {
+			var cert Certificate
+			if err := json.Unmarshal(v, &cert); err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		return nil
+	})
+
+	return certs, err
+}
+
+func (s *BoltStore) Tip(ctx context.Context) (*Certificate, error) {
+	var cert *Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(blockIndexBucket).Cursor()
+		k, id := c.Last()
+		if k == nil {
+			return nil
+		}
+
+		v := tx.Bucket(certsBucket).Get(id)
+		if v == nil {
+			return nil
+		}
+
+		cert = &Certificate{}
+		return json.Unmarshal(v, cert)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, ErrNotFound
+	}
+
+	return cert, nil
+}
+
+// DeleteOlderThan removes every certificate with a block number below
+// blockNumber, along with its entries in the secondary indexes.
+func (s *BoltStore) DeleteOlderThan(ctx context.Context, blockNumber uint64) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		certBucket := tx.Bucket(certsBucket)
+		blockBucket := tx.Bucket(blockIndexBucket)
+		epochBucket := tx.Bucket(epochIndexBucket)
+
+		// Collect every matching key/id pair first. Cursor.Delete does
+		// not advance the cursor, so deleting while walking it forward
+		// with Next() would skip the entry that shifts into the freed
+		// slot; gathering keys up front and deleting afterwards avoids
+		// that.
+		cur := blockBucket.Cursor()
+		stop := blockIndexPrefix(blockNumber)
+
+		var staleBlockKeys [][]byte
+		var staleIDs [][]byte
+		for k, id := cur.First(); k != nil && bytes.Compare(k, stop) < 0; k, id = cur.Next() {
+			staleBlockKeys = append(staleBlockKeys, append([]byte(nil), k...))
+			staleIDs = append(staleIDs, append([]byte(nil), id...))
+		}
+
+		for i, id := range staleIDs {
+			if v := certBucket.Get(id); v != nil {
+				var cert Certificate
+				if err := json.Unmarshal(v, &cert); err != nil {
+					return err
+				}
+				if err := epochBucket.Delete(epochIndexKey(cert.Epoch, cert.BlockNumber, cert.Id)); err != nil {
+					return err
+				}
+				if err := certBucket.Delete(id); err != nil {
+					return err
+				}
+			}
+			if err := blockBucket.Delete(staleBlockKeys[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *BoltStore) SaveVerification(ctx context.Context, id string, meta VerifiedMetadata) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(certsBucket)
+
+		v := b.Get([]byte(id))
+		if v == nil {
+			return ErrNotFound
+		}
+
+		var cert Certificate
+		if err := json.Unmarshal(v, &cert); err != nil {
+			return err
+		}
+
+		cert.SignerCount = meta.SignerCount
+		cert.TotalStake = meta.TotalStake
+		cert.CertHash = meta.CertHash
+		cert.ExpiresAt = meta.ExpiresAt
+
+		buf, err := json.Marshal(&cert)
+		if err != nil {
+			return err
+		}
+
+		return b.Put([]byte(id), buf)
+	})
+}
+
+func (s *BoltStore) LatestVerified(ctx context.Context) (*Certificate, error) {
+	var cert *Certificate
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(blockIndexBucket).Cursor()
+		certBucket := tx.Bucket(certsBucket)
+
+		for k, id := c.Last(); k != nil; k, id = c.Prev() {
+			v := certBucket.Get(id)
+			if v == nil {
+				continue
+			}
+
+			var candidate Certificate
+			if err := json.Unmarshal(v, &candidate); err != nil {
+				return err
+			}
+			if candidate.Verified() {
+				cert = &candidate
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		return nil, ErrNotFound
+	}
+
+	return cert, nil
+}
+
+func (s *BoltStore) ByID(ctx context.Context, id string) (*Certificate, error) {
+	var cert Certificate
+	found := false
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(certsBucket).Get([]byte(id))
+		if v == nil {
+			return nil
+		}
+		found = true
+		return json.Unmarshal(v, &cert)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	return &cert, nil
+}
+
+// blockIndexKey builds a blk/<be-uint64>/<id> composite key so that
+// lexicographic byte ordering matches numeric block-number ordering.
+func blockIndexKey(blockNumber uint64, id string) []byte {
+	return append(blockIndexPrefix(blockNumber), []byte(id)...)
+}
+
+func blockIndexPrefix(blockNumber uint64) []byte {
+	return append([]byte("blk/"), blockIndexSuffix(blockNumber)...)
+}
+
+// blockIndexSuffix encodes blockNumber as an 8-byte big-endian value
+// followed by a separator, for embedding in composite index keys.
+func blockIndexSuffix(blockNumber uint64) []byte {
+	buf := make([]byte, 9)
+	binary.BigEndian.PutUint64(buf, blockNumber)
+	buf[8] = '/'
+	return buf
+}
+
+// epochIndexKey builds an epoch/<be-uint64 epoch>/<be-uint64 block
+// number>/<id> composite key, so that within an epoch's range,
+// lexicographic byte ordering matches numeric block-number ordering.
+func epochIndexKey(epoch, blockNumber uint64, id string) []byte {
+	key := append(epochIndexPrefix(epoch), blockIndexSuffix(blockNumber)...)
+	return append(key, []byte(id)...)
+}
+
+func epochIndexPrefix(epoch uint64) []byte {
+	buf := make([]byte, 6, 6+8+1)
+	copy(buf, "epoch/")
+	be := make([]byte, 8)
+	binary.BigEndian.PutUint64(be, epoch)
+	return append(append(buf, be...), '/')
+}
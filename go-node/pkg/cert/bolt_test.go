@@ -0,0 +1,269 @@
+package cert
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestBoltStore(t *testing.T) *BoltStore {
+	t.Helper()
+
+	s, err := NewBoltStore(filepath.Join(t.TempDir(), "certs.db"))
+	if err != nil {
+		t.Fatalf("NewBoltStore: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return s
+}
+
+func seedCert(id string, epoch, blockNumber uint64) Certificate {
+	return Certificate{
+		Id:          id,
+		Epoch:       epoch,
+		BlockNumber: blockNumber,
+		BlockHash:   "hash-" + id,
+		SigFinishedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).
+			Add(time.Duration(blockNumber) * time.Hour),
+	}
+}
+
+func TestBoltStoreByBlockRangeAndByEpoch(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	certs := []Certificate{
+		seedCert("c1", 1, 10),
+		seedCert("c2", 1, 20),
+		seedCert("c3", 2, 30),
+		seedCert("c4", 2, 40),
+	}
+	for _, c := range certs {
+		c := c
+		if err := s.Save(ctx, &c); err != nil {
+			t.Fatalf("Save(%s): %v", c.Id, err)
+		}
+	}
+
+	got, err := s.ByBlockRange(ctx, 15, 35)
+	if err != nil {
+		t.Fatalf("ByBlockRange: %v", err)
+	}
+	if len(got) != 2 || got[0].Id != "c2" || got[1].Id != "c3" {
+		t.Fatalf("ByBlockRange(15,35) = %+v, want [c2 c3]", got)
+	}
+
+	byEpoch, err := s.ByEpoch(ctx, 2)
+	if err != nil {
+		t.Fatalf("ByEpoch: %v", err)
+	}
+	if len(byEpoch) != 2 || byEpoch[0].Id != "c3" || byEpoch[1].Id != "c4" {
+		t.Fatalf("ByEpoch(2) = %+v, want [c3 c4]", byEpoch)
+	}
+}
+
+// TestBoltStoreByEpochOrdersByBlockNumber uses ids that sort the opposite
+// way from their block numbers, so a ByEpoch implementation that orders by
+// id (instead of block number, as Store.ByEpoch requires) would fail this.
+func TestBoltStoreByEpochOrdersByBlockNumber(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	for _, c := range []Certificate{seedCert("zzz", 5, 10), seedCert("aaa", 5, 20)} {
+		c := c
+		if err := s.Save(ctx, &c); err != nil {
+			t.Fatalf("Save(%s): %v", c.Id, err)
+		}
+	}
+
+	got, err := s.ByEpoch(ctx, 5)
+	if err != nil {
+		t.Fatalf("ByEpoch: %v", err)
+	}
+	if len(got) != 2 || got[0].Id != "zzz" || got[1].Id != "aaa" {
+		t.Fatalf("ByEpoch(5) = %+v, want [zzz aaa] (block-number order)", got)
+	}
+}
+
+// TestBoltStoreSaveReplacesStaleIndexEntries ensures re-saving a
+// certificate under a new block number/epoch doesn't leave the old
+// secondary index entries pointing at it.
+func TestBoltStoreSaveReplacesStaleIndexEntries(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	c := seedCert("c1", 1, 10)
+	if err := s.Save(ctx, &c); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	moved := seedCert("c1", 2, 20)
+	if err := s.Save(ctx, &moved); err != nil {
+		t.Fatalf("Save (moved): %v", err)
+	}
+
+	byOldEpoch, err := s.ByEpoch(ctx, 1)
+	if err != nil {
+		t.Fatalf("ByEpoch(1): %v", err)
+	}
+	if len(byOldEpoch) != 0 {
+		t.Fatalf("ByEpoch(1) after move = %+v, want empty", byOldEpoch)
+	}
+
+	byOldRange, err := s.ByBlockRange(ctx, 0, 15)
+	if err != nil {
+		t.Fatalf("ByBlockRange(0,15): %v", err)
+	}
+	if len(byOldRange) != 0 {
+		t.Fatalf("ByBlockRange(0,15) after move = %+v, want empty", byOldRange)
+	}
+
+	byNewEpoch, err := s.ByEpoch(ctx, 2)
+	if err != nil {
+		t.Fatalf("ByEpoch(2): %v", err)
+	}
+	if len(byNewEpoch) != 1 || byNewEpoch[0].Id != "c1" {
+		t.Fatalf("ByEpoch(2) after move = %+v, want [c1]", byNewEpoch)
+	}
+}
+
+func TestBoltStoreTipAndDeleteOlderThan(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	for _, c := range []Certificate{seedCert("c1", 1, 10), seedCert("c2", 1, 20), seedCert("c3", 2, 30)} {
+		c := c
+		if err := s.Save(ctx, &c); err != nil {
+			t.Fatalf("Save(%s): %v", c.Id, err)
+		}
+	}
+
+	tip, err := s.Tip(ctx)
+	if err != nil {
+		t.Fatalf("Tip: %v", err)
+	}
+	if tip.Id != "c3" {
+		t.Fatalf("Tip().Id = %q, want c3", tip.Id)
+	}
+
+	if err := s.DeleteOlderThan(ctx, 20); err != nil {
+		t.Fatalf("DeleteOlderThan: %v", err)
+	}
+
+	if _, err := s.ByID(ctx, "c1"); err != ErrNotFound {
+		t.Fatalf("ByID(c1) after prune: err = %v, want ErrNotFound", err)
+	}
+	if _, err := s.ByID(ctx, "c2"); err != nil {
+		t.Fatalf("ByID(c2) after prune (at boundary, should survive): %v", err)
+	}
+
+	remaining, err := s.ByBlockRange(ctx, 0, 100)
+	if err != nil {
+		t.Fatalf("ByBlockRange after prune: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Fatalf("remaining certs after DeleteOlderThan(20) = %d, want 2", len(remaining))
+	}
+}
+
+// TestBoltStoreDeleteOlderThanConsecutive seeds several consecutive
+// certificates below the cutoff in one call, which exercises the
+// multi-delete path that a skip bug in DeleteOlderThan's iteration would
+// otherwise leave half of untouched.
+func TestBoltStoreDeleteOlderThanConsecutive(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	for _, c := range []Certificate{
+		seedCert("c1", 1, 10),
+		seedCert("c2", 1, 20),
+		seedCert("c3", 1, 30),
+		seedCert("c4", 1, 40),
+		seedCert("c5", 1, 50),
+	} {
+		c := c
+		if err := s.Save(ctx, &c); err != nil {
+			t.Fatalf("Save(%s): %v", c.Id, err)
+		}
+	}
+
+	if err := s.DeleteOlderThan(ctx, 50); err != nil {
+		t.Fatalf("DeleteOlderThan: %v", err)
+	}
+
+	for _, id := range []string{"c1", "c2", "c3", "c4"} {
+		if _, err := s.ByID(ctx, id); err != ErrNotFound {
+			t.Fatalf("ByID(%s) after prune: err = %v, want ErrNotFound", id, err)
+		}
+	}
+	if _, err := s.ByID(ctx, "c5"); err != nil {
+		t.Fatalf("ByID(c5) after prune: %v", err)
+	}
+
+	byRange, err := s.ByBlockRange(ctx, 0, 100)
+	if err != nil {
+		t.Fatalf("ByBlockRange: %v", err)
+	}
+	if len(byRange) != 1 || byRange[0].Id != "c5" {
+		t.Fatalf("ByBlockRange(0,100) after prune = %+v, want [c5]", byRange)
+	}
+
+	byEpoch, err := s.ByEpoch(ctx, 1)
+	if err != nil {
+		t.Fatalf("ByEpoch: %v", err)
+	}
+	if len(byEpoch) != 1 || byEpoch[0].Id != "c5" {
+		t.Fatalf("ByEpoch(1) after prune = %+v, want [c5]", byEpoch)
+	}
+}
+
+// TestBoltStoreByBlockRangeMaxUint64 guards against to+1 overflowing when
+// the caller passes math.MaxUint64 as an open-ended upper bound.
+func TestBoltStoreByBlockRangeMaxUint64(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	c := seedCert("c1", 1, math.MaxUint64)
+	if err := s.Save(ctx, &c); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := s.ByBlockRange(ctx, 0, math.MaxUint64)
+	if err != nil {
+		t.Fatalf("ByBlockRange: %v", err)
+	}
+	if len(got) != 1 || got[0].Id != "c1" {
+		t.Fatalf("ByBlockRange(0,MaxUint64) = %+v, want [c1]", got)
+	}
+}
+
+func TestBoltStorePaginate(t *testing.T) {
+	ctx := context.Background()
+	s := newTestBoltStore(t)
+
+	for _, id := range []string{"a", "b", "c", "d"} {
+		c := seedCert(id, 1, 1)
+		if err := s.Save(ctx, &c); err != nil {
+			t.Fatalf("Save(%s): %v", id, err)
+		}
+	}
+
+	page1, err := s.Paginate(ctx, "", 2)
+	if err != nil {
+		t.Fatalf("Paginate: %v", err)
+	}
+	if len(page1) != 2 || page1[0].Id != "a" || page1[1].Id != "b" {
+		t.Fatalf("first page = %+v, want [a b]", page1)
+	}
+
+	page2, err := s.Paginate(ctx, page1[len(page1)-1].Id, 2)
+	if err != nil {
+		t.Fatalf("Paginate: %v", err)
+	}
+	if len(page2) != 2 || page2[0].Id != "c" || page2[1].Id != "d" {
+		t.Fatalf("second page = %+v, want [c d]", page2)
+	}
+}
@@ -0,0 +1,29 @@
+package cert
+
+import "time"
+
+// Certificate is a single Mithril certificate as observed on chain, covering
+// the multi-signature produced by the signer set for a given block.
+type Certificate struct {
+	Id            string
+	Epoch         uint64
+	BlockNumber   uint64
+	BlockHash     string
+	MerkleRoot    string
+	MultiSig      []byte
+	SigStartedAt  time.Time
+	SigFinishedAt time.Time
+
+	// The fields below are populated once the certificate has been
+	// verified, via Verifier.Verify. They are zero-valued until then.
+	SignerCount uint32
+	TotalStake  uint64
+	CertHash    string
+	ExpiresAt   time.Time
+}
+
+// Verified reports whether this certificate has gone through
+// Verifier.Verify, based on whether verification metadata was populated.
+func (c *Certificate) Verified() bool {
+	return c.CertHash != ""
+}
@@ -0,0 +1,7 @@
+package cert
+
+import "errors"
+
+// ErrNotFound is returned by Store lookups when no matching certificate
+// exists.
+var ErrNotFound = errors.New("cert: not found")
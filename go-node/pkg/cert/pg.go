@@ -0,0 +1,223 @@
+package cert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PgStore is the Postgres-backed Store implementation. It owns a
+// connection pool and wraps each call in its own transaction so callers
+// never need to thread a pgx.Tx through.
+//
+// PgStore depends on columns added after the original mithril_certificates
+// table in migrations/0001_cert_store_columns.sql; apply it before
+// pointing a PgStore at an existing database.
+type PgStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPgStore wraps an existing pgx connection pool as a Store.
+func NewPgStore(pool *pgxpool.Pool) *PgStore {
+	return &PgStore{pool: pool}
+}
+
+var _ Store = (*PgStore)(nil)
+
+const certColumns = `id, epoch, block_number, block_hash, merkle_root, multi_sig, sig_started_at, sig_finished_at,
+	signer_count, total_stake, cert_hash, expires_at`
+
+func (s *PgStore) Save(ctx context.Context, cert *Certificate) error {
+	stmt := `insert into mithril_certificates
+				(id, epoch, block_number, block_hash, merkle_root, multi_sig, sig_started_at, sig_finished_at)
+				values ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := s.pool.Exec(ctx, stmt,
+		cert.Id,
+		cert.Epoch,
+		cert.BlockNumber,
+		cert.BlockHash,
+		cert.MerkleRoot,
+		cert.MultiSig,
+		cert.SigStartedAt,
+		cert.SigFinishedAt,
+	)
+	return err
+}
+
+func (s *PgStore) Recent(ctx context.Context) ([]Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				order by id desc limit 20`
+
+	rows, err := s.pool.Query(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCerts(rows)
+}
+
+// ByBlockRange relies on the index on block_number to keep the scan
+// proportional to the size of the range rather than the whole table.
+func (s *PgStore) ByBlockRange(ctx context.Context, from, to uint64) ([]Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				where block_number between $1 and $2
+				order by block_number asc`
+
+	rows, err := s.pool.Query(ctx, stmt, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCerts(rows)
+}
+
+func (s *PgStore) ByID(ctx context.Context, id string) (*Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				where id = $1`
+
+	c, err := scanCert(s.pool.QueryRow(ctx, stmt, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// ByEpoch relies on the index on epoch to keep the scan proportional to
+// the number of certificates signed during that epoch.
+func (s *PgStore) ByEpoch(ctx context.Context, epoch uint64) ([]Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				where epoch = $1
+				order by block_number asc`
+
+	rows, err := s.pool.Query(ctx, stmt, epoch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCerts(rows)
+}
+
+func (s *PgStore) Paginate(ctx context.Context, afterID string, limit int) ([]Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				where id > $1
+				order by id asc limit $2`
+
+	rows, err := s.pool.Query(ctx, stmt, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCerts(rows)
+}
+
+func (s *PgStore) SaveVerification(ctx context.Context, id string, meta VerifiedMetadata) error {
+	stmt := `update mithril_certificates
+				set signer_count = $2, total_stake = $3, cert_hash = $4, expires_at = $5
+				where id = $1`
+
+	_, err := s.pool.Exec(ctx, stmt, id, meta.SignerCount, meta.TotalStake, meta.CertHash, meta.ExpiresAt)
+	return err
+}
+
+func (s *PgStore) LatestVerified(ctx context.Context) (*Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				where cert_hash is not null
+				order by block_number desc limit 1`
+
+	c, err := scanCert(s.pool.QueryRow(ctx, stmt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *PgStore) Tip(ctx context.Context) (*Certificate, error) {
+	stmt := `select ` + certColumns + `
+				from mithril_certificates
+				order by block_number desc limit 1`
+
+	c, err := scanCert(s.pool.QueryRow(ctx, stmt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *PgStore) DeleteOlderThan(ctx context.Context, blockNumber uint64) error {
+	stmt := `delete from mithril_certificates where block_number < $1`
+
+	_, err := s.pool.Exec(ctx, stmt, blockNumber)
+	return err
+}
+
+// rowScanner is satisfied by both pgx.Row and pgx.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCert reads one certificate row, tolerating the verification columns
+// being NULL for certificates that have not gone through Verifier.Verify.
+func scanCert(row rowScanner) (*Certificate, error) {
+	var c Certificate
+	var signerCount, totalStake sql.NullInt64
+	var certHash sql.NullString
+	var expiresAt sql.NullTime
+
+	err := row.Scan(&c.Id, &c.Epoch, &c.BlockNumber, &c.BlockHash, &c.MerkleRoot,
+		&c.MultiSig, &c.SigStartedAt, &c.SigFinishedAt,
+		&signerCount, &totalStake, &certHash, &expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.SignerCount = uint32(signerCount.Int64)
+	c.TotalStake = uint64(totalStake.Int64)
+	c.CertHash = certHash.String
+	c.ExpiresAt = expiresAt.Time
+
+	return &c, nil
+}
+
+// scanCerts drains a pgx.Rows of the standard certificate column set.
+func scanCerts(rows interface {
+	Next() bool
+	rowScanner
+	Err() error
+}) ([]Certificate, error) {
+	var certs []Certificate
+	for rows.Next() {
+		c, err := scanCert(rows)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, *c)
+	}
+
+	return certs, rows.Err()
+}
@@ -0,0 +1,103 @@
+package cert
+
+import (
+	"crypto/ed25519"
+	"testing"
+)
+
+func mustSignEntry(t *testing.T, partyID string, stake uint64, msg []byte) MultiSigEntry {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	return MultiSigEntry{
+		PartyID:   partyID,
+		Stake:     stake,
+		PublicKey: pub,
+		Signature: ed25519.Sign(priv, msg),
+	}
+}
+
+func TestDefaultMultiSigVerifierQuorumReached(t *testing.T) {
+	merkleRoot := "deadbeef"
+
+	e1 := mustSignEntry(t, "pool-a", 600, []byte(merkleRoot))
+	e2 := mustSignEntry(t, "pool-b", 100, []byte(merkleRoot))
+
+	sig, err := EncodeMultiSig([]MultiSigEntry{e1, e2})
+	if err != nil {
+		t.Fatalf("EncodeMultiSig: %v", err)
+	}
+
+	stakes := map[string]uint64{"pool-a": 600, "pool-b": 100, "pool-c": 300}
+
+	v := DefaultMultiSigVerifier{}
+	signerCount, totalStake, err := v.Verify(merkleRoot, sig, stakes)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if signerCount != 2 {
+		t.Fatalf("signerCount = %d, want 2", signerCount)
+	}
+	if totalStake != 700 {
+		t.Fatalf("totalStake = %d, want 700", totalStake)
+	}
+}
+
+func TestDefaultMultiSigVerifierInsufficientQuorum(t *testing.T) {
+	merkleRoot := "deadbeef"
+
+	e1 := mustSignEntry(t, "pool-a", 400, []byte(merkleRoot))
+
+	sig, err := EncodeMultiSig([]MultiSigEntry{e1})
+	if err != nil {
+		t.Fatalf("EncodeMultiSig: %v", err)
+	}
+
+	stakes := map[string]uint64{"pool-a": 400, "pool-b": 600}
+
+	v := DefaultMultiSigVerifier{}
+	if _, _, err := v.Verify(merkleRoot, sig, stakes); err == nil {
+		t.Fatalf("Verify succeeded with only 400/1000 stake, want quorum error")
+	}
+}
+
+func TestDefaultMultiSigVerifierTamperedSignature(t *testing.T) {
+	merkleRoot := "deadbeef"
+
+	e1 := mustSignEntry(t, "pool-a", 900, []byte("a different merkle root"))
+
+	sig, err := EncodeMultiSig([]MultiSigEntry{e1})
+	if err != nil {
+		t.Fatalf("EncodeMultiSig: %v", err)
+	}
+
+	stakes := map[string]uint64{"pool-a": 900, "pool-b": 100}
+
+	v := DefaultMultiSigVerifier{}
+	if _, _, err := v.Verify(merkleRoot, sig, stakes); err == nil {
+		t.Fatalf("Verify succeeded despite signature over a different message")
+	}
+}
+
+func TestDefaultMultiSigVerifierStakeMismatch(t *testing.T) {
+	merkleRoot := "deadbeef"
+
+	e1 := mustSignEntry(t, "pool-a", 900, []byte(merkleRoot))
+	e1.Stake = 50 // claims far less stake than the distribution records
+
+	sig, err := EncodeMultiSig([]MultiSigEntry{e1})
+	if err != nil {
+		t.Fatalf("EncodeMultiSig: %v", err)
+	}
+
+	stakes := map[string]uint64{"pool-a": 900}
+
+	v := DefaultMultiSigVerifier{}
+	if _, _, err := v.Verify(merkleRoot, sig, stakes); err == nil {
+		t.Fatalf("Verify succeeded despite stake claim not matching distribution")
+	}
+}
@@ -1,47 +1,48 @@
 package cert
 
-import (
-	"context"
-	"github.com/jackc/pgx/v4"
-)
-
-func Save(ctx context.Context, tx pgx.Tx, cert *Certificate) error {
-	stmt := `insert into mithril_certificates
-				(id, block_number, block_hash, merkle_root, multi_sig, sig_started_at, sig_finished_at)
-				values ($1, $2, $3, $4, $5, $6, $7)`
-
-	_, err := tx.Exec(ctx, stmt,
-		cert.Id,
-		cert.BlockNumber,
-		cert.BlockHash,
-		cert.MerkleRoot,
-		cert.MultiSig,
-		cert.SigStartedAt,
-		cert.SigFinishedAt,
-	)
-	return err
-}
+import "context"
+
+// Store persists and retrieves Mithril certificates. Implementations own
+// their own transaction handling, so callers never see the underlying
+// database driver types.
+type Store interface {
+	// Save inserts a new certificate.
+	Save(ctx context.Context, cert *Certificate) error
+
+	// Recent returns the most recently observed certificates, newest first.
+	Recent(ctx context.Context) ([]Certificate, error)
+
+	// ByBlockRange returns certificates whose block number falls within
+	// [from, to], ordered by block number ascending.
+	ByBlockRange(ctx context.Context, from, to uint64) ([]Certificate, error)
+
+	// ByID returns the certificate with the given id, or ErrNotFound if
+	// none exists.
+	ByID(ctx context.Context, id string) (*Certificate, error)
+
+	// ByEpoch returns the certificates signed during the given epoch,
+	// ordered by block number ascending.
+	ByEpoch(ctx context.Context, epoch uint64) ([]Certificate, error)
 
-func Recent(ctx context.Context, tx pgx.Tx) ([]Certificate, error) {
-	stmt := `select id, block_number, block_hash, merkle_root, multi_sig, sig_started_at, sig_finished_at
-				from mithril_certificates
-				order by id desc limit 20`
-	rows, err := tx.Query(ctx, stmt)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var certs []Certificate
-	for rows.Next() {
-		var c Certificate
-		err := rows.Scan(&c.Id, &c.BlockNumber, &c.BlockHash, &c.MerkleRoot,
-			&c.MultiSig, &c.SigStartedAt, &c.SigFinishedAt)
-		if err != nil {
-			return nil, err
-		}
-		certs = append(certs, c)
-	}
-
-	return certs, nil
-}
\ No newline at end of file
+	// Paginate returns up to limit certificates ordered by id ascending,
+	// starting after afterID. Pass an empty afterID to start from the
+	// beginning.
+	Paginate(ctx context.Context, afterID string, limit int) ([]Certificate, error)
+
+	// SaveVerification persists the verification metadata produced by
+	// Verifier.Verify against the certificate with the given id.
+	SaveVerification(ctx context.Context, id string, meta VerifiedMetadata) error
+
+	// LatestVerified returns the most recent certificate that has been
+	// through Verifier.Verify, or ErrNotFound if none has.
+	LatestVerified(ctx context.Context) (*Certificate, error)
+
+	// Tip returns the certificate with the highest block number, or
+	// ErrNotFound if the store is empty.
+	Tip(ctx context.Context) (*Certificate, error)
+
+	// DeleteOlderThan removes all certificates with a block number below
+	// blockNumber, so long-running nodes can keep mithril_certificates
+	// bounded in size.
+	DeleteOlderThan(ctx context.Context, blockNumber uint64) error
+}
@@ -0,0 +1,110 @@
+package cert
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// VerifiedMetadata is the structured result of verifying a certificate's
+// multi-signature, derived rather than stored opaquely so it can be
+// indexed and queried directly. SignedAt is not included here: it is
+// already exposed on Certificate as SigFinishedAt, so it isn't duplicated
+// or re-persisted.
+type VerifiedMetadata struct {
+	SignerCount uint32
+	TotalStake  uint64
+	CertHash    string
+	ExpiresAt   time.Time
+}
+
+// MultiSigVerifier checks an STM (Mithril) multi-signature against a
+// Merkle root and a stake distribution. DefaultMultiSigVerifier is the
+// concrete implementation shipped in this package; it verifies Ed25519
+// signatures per party rather than the BLS-based lottery scheme the
+// Mithril protocol itself uses for STM multi-signatures, since that
+// requires vendoring mithril-common. The interface is kept separate from
+// persistence so a real STM backend can be swapped in later without
+// touching Store or Verifier.
+type MultiSigVerifier interface {
+	// Verify returns the number of distinct signers and their aggregate
+	// stake if sig is a valid multi-signature over merkleRoot under
+	// stakes, or an error otherwise.
+	Verify(merkleRoot string, sig []byte, stakes map[string]uint64) (signerCount uint32, totalStake uint64, err error)
+}
+
+// StakeDistributionProvider resolves the stake distribution in effect
+// for a given epoch, keyed by signer party id.
+type StakeDistributionProvider interface {
+	StakeDistribution(ctx context.Context, epoch uint64) (map[string]uint64, error)
+}
+
+// Verifier checks certificates against their multi-signature and stake
+// distribution and records the resulting metadata via a Store.
+type Verifier struct {
+	store  Store
+	sig    MultiSigVerifier
+	stakes StakeDistributionProvider
+	ttl    time.Duration
+}
+
+// NewVerifier builds a Verifier. ttl controls how long a certificate is
+// considered valid after it finished signing, used to populate ExpiresAt.
+func NewVerifier(store Store, sig MultiSigVerifier, stakes StakeDistributionProvider, ttl time.Duration) *Verifier {
+	return &Verifier{store: store, sig: sig, stakes: stakes, ttl: ttl}
+}
+
+// Verify loads the certificate with the given id, checks its
+// multi-signature against its Merkle root and the stake distribution for
+// its epoch, persists the resulting metadata, and returns the updated
+// certificate.
+func (v *Verifier) Verify(ctx context.Context, id string) (*Certificate, error) {
+	cert, err := v.store.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stakes, err := v.stakes.StakeDistribution(ctx, cert.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	signerCount, totalStake, err := v.sig.Verify(cert.MerkleRoot, cert.MultiSig, stakes)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := VerifiedMetadata{
+		SignerCount: signerCount,
+		TotalStake:  totalStake,
+		CertHash:    certHash(cert),
+		ExpiresAt:   cert.SigFinishedAt.Add(v.ttl),
+	}
+
+	if err := v.store.SaveVerification(ctx, id, meta); err != nil {
+		return nil, err
+	}
+
+	cert.SignerCount = meta.SignerCount
+	cert.TotalStake = meta.TotalStake
+	cert.CertHash = meta.CertHash
+	cert.ExpiresAt = meta.ExpiresAt
+
+	return cert, nil
+}
+
+// LatestVerified returns the most recently verified certificate.
+func (v *Verifier) LatestVerified(ctx context.Context) (*Certificate, error) {
+	return v.store.LatestVerified(ctx)
+}
+
+// certHash derives a content hash identifying this certificate's signed
+// payload, independent of its id.
+func certHash(cert *Certificate) string {
+	h := sha256.New()
+	h.Write([]byte(cert.BlockHash))
+	h.Write([]byte(cert.MerkleRoot))
+	h.Write(cert.MultiSig)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,161 @@
+package cert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeStore is a minimal in-memory Store used only to exercise Verifier's
+// orchestration (load -> stakes -> verify -> persist) in isolation from
+// any real backend.
+type fakeStore struct {
+	certs map[string]Certificate
+}
+
+func newFakeStore(certs ...Certificate) *fakeStore {
+	s := &fakeStore{certs: map[string]Certificate{}}
+	for _, c := range certs {
+		s.certs[c.Id] = c
+	}
+	return s
+}
+
+var _ Store = (*fakeStore)(nil)
+
+func (s *fakeStore) Save(ctx context.Context, cert *Certificate) error {
+	s.certs[cert.Id] = *cert
+	return nil
+}
+
+func (s *fakeStore) Recent(ctx context.Context) ([]Certificate, error) { return nil, nil }
+
+func (s *fakeStore) ByBlockRange(ctx context.Context, from, to uint64) ([]Certificate, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) ByID(ctx context.Context, id string) (*Certificate, error) {
+	c, ok := s.certs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &c, nil
+}
+
+func (s *fakeStore) ByEpoch(ctx context.Context, epoch uint64) ([]Certificate, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) Paginate(ctx context.Context, afterID string, limit int) ([]Certificate, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) SaveVerification(ctx context.Context, id string, meta VerifiedMetadata) error {
+	c, ok := s.certs[id]
+	if !ok {
+		return ErrNotFound
+	}
+	c.SignerCount = meta.SignerCount
+	c.TotalStake = meta.TotalStake
+	c.CertHash = meta.CertHash
+	c.ExpiresAt = meta.ExpiresAt
+	s.certs[id] = c
+	return nil
+}
+
+func (s *fakeStore) LatestVerified(ctx context.Context) (*Certificate, error) {
+	for _, c := range s.certs {
+		if c.Verified() {
+			return &c, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *fakeStore) Tip(ctx context.Context) (*Certificate, error) { return nil, ErrNotFound }
+
+func (s *fakeStore) DeleteOlderThan(ctx context.Context, blockNumber uint64) error { return nil }
+
+// stubMultiSigVerifier returns a fixed result without doing any real
+// cryptography, standing in for the STM implementation this package
+// doesn't ship yet.
+type stubMultiSigVerifier struct {
+	signerCount uint32
+	totalStake  uint64
+	err         error
+}
+
+func (s stubMultiSigVerifier) Verify(merkleRoot string, sig []byte, stakes map[string]uint64) (uint32, uint64, error) {
+	return s.signerCount, s.totalStake, s.err
+}
+
+type stubStakeDistributionProvider struct {
+	stakes map[string]uint64
+}
+
+func (s stubStakeDistributionProvider) StakeDistribution(ctx context.Context, epoch uint64) (map[string]uint64, error) {
+	return s.stakes, nil
+}
+
+func TestVerifierVerify(t *testing.T) {
+	signedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newFakeStore(Certificate{
+		Id:            "cert-1",
+		Epoch:         7,
+		MerkleRoot:    "deadbeef",
+		MultiSig:      []byte("sig-bytes"),
+		SigFinishedAt: signedAt,
+	})
+
+	ttl := 24 * time.Hour
+	v := NewVerifier(store, stubMultiSigVerifier{signerCount: 3, totalStake: 900}, stubStakeDistributionProvider{
+		stakes: map[string]uint64{"pool-a": 500, "pool-b": 400},
+	}, ttl)
+
+	got, err := v.Verify(context.Background(), "cert-1")
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+
+	if got.SignerCount != 3 || got.TotalStake != 900 {
+		t.Fatalf("unexpected metadata: signerCount=%d totalStake=%d", got.SignerCount, got.TotalStake)
+	}
+	if got.CertHash == "" {
+		t.Fatalf("expected CertHash to be populated")
+	}
+	if !got.ExpiresAt.Equal(signedAt.Add(ttl)) {
+		t.Fatalf("ExpiresAt = %v, want %v", got.ExpiresAt, signedAt.Add(ttl))
+	}
+	if !got.Verified() {
+		t.Fatalf("expected certificate to report Verified() == true")
+	}
+
+	stored, err := store.ByID(context.Background(), "cert-1")
+	if err != nil {
+		t.Fatalf("ByID returned error: %v", err)
+	}
+	if !stored.Verified() {
+		t.Fatalf("expected verification metadata to be persisted to the store")
+	}
+
+	latest, err := v.LatestVerified(context.Background())
+	if err != nil {
+		t.Fatalf("LatestVerified returned error: %v", err)
+	}
+	if latest.Id != "cert-1" {
+		t.Fatalf("LatestVerified returned %q, want cert-1", latest.Id)
+	}
+}
+
+func TestVerifierVerifyPropagatesSigError(t *testing.T) {
+	store := newFakeStore(Certificate{Id: "cert-1", Epoch: 1})
+
+	sentinel := errors.New("invalid multi-signature")
+	v := NewVerifier(store, stubMultiSigVerifier{err: sentinel}, stubStakeDistributionProvider{}, time.Hour)
+
+	_, err := v.Verify(context.Background(), "cert-1")
+	if err != sentinel {
+		t.Fatalf("Verify error = %v, want %v", err, sentinel)
+	}
+}